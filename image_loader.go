@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+)
+
+// halfBlock is the Unicode upper-half-block character used to render two
+// vertically adjacent source pixels (top = foreground, bottom = background)
+// in a single terminal cell, doubling vertical resolution per cell.
+const halfBlock = "▀"
+
+// loadAnimationDataFromImage loads a static image or animated GIF from disk
+// and converts it into the same [][]string frame format that
+// Animation.Initialize consumes. For GIFs, it also returns each frame's
+// delay in milliseconds so playback can honor the source's variable timing
+// (one delay per frame, even for a single-frame GIF); for static images the
+// returned delays are nil and the caller falls back to the fixed FrameDelay.
+func loadAnimationDataFromImage(path string, isGif bool) ([][]string, []int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	if isGif {
+		return loadAnimationDataFromGif(file)
+	}
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding image: %w", err)
+	}
+
+	return [][]string{renderFrame(resizeNearestNeighbor(img, ImageWidth, ImageHeight*2))}, nil, nil
+}
+
+// loadAnimationDataFromGif decodes an animated GIF, composing each frame
+// against the previous one according to its disposal method, and returns
+// the rendered frame lines alongside their per-frame delays.
+func loadAnimationDataFromGif(file *os.File) ([][]string, []int, error) {
+	g, err := gif.DecodeAll(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding gif: %w", err)
+	}
+
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	canvas := image.NewRGBA(bounds)
+	if g.BackgroundIndex < uint8(len(g.Image[0].Palette)) {
+		draw.Draw(canvas, bounds, image.NewUniform(g.Image[0].Palette[g.BackgroundIndex]), image.Point{}, draw.Src)
+	}
+
+	frames := make([][]string, len(g.Image))
+	delaysMs := make([]int, len(g.Image))
+
+	for i, frameImg := range g.Image {
+		draw.Draw(canvas, frameImg.Bounds(), frameImg, frameImg.Bounds().Min, draw.Over)
+		frames[i] = renderFrame(resizeNearestNeighbor(canvas, ImageWidth, ImageHeight*2))
+		delaysMs[i] = g.Delay[i] * 10 // GIF delays are in hundredths of a second
+
+		// DisposalBackground clears the frame's region before the next
+		// frame is composed; DisposalNone (and DisposalPrevious, which we
+		// can't distinguish without keeping extra history) leave it in
+		// place, which is the common case for gostty-sized animations.
+		if g.Disposal[i] == gif.DisposalBackground {
+			draw.Draw(canvas, frameImg.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		}
+	}
+
+	return frames, delaysMs, nil
+}
+
+// resizeNearestNeighbor scales img to width x height using nearest-neighbor
+// sampling, which is cheap and keeps hard edges crisp for block rendering.
+func resizeNearestNeighbor(img image.Image, width, height int) *image.RGBA {
+	srcBounds := img.Bounds()
+	srcW, srcH := srcBounds.Dx(), srcBounds.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		srcY := srcBounds.Min.Y + y*srcH/height
+		for x := 0; x < width; x++ {
+			srcX := srcBounds.Min.X + x*srcW/width
+			dst.Set(x, y, img.At(srcX, srcY))
+		}
+	}
+	return dst
+}
+
+// renderFrame converts a resized RGBA image into terminal lines, pairing
+// vertically adjacent pixel rows into a single half-block character per
+// cell: foreground is the top pixel, background is the bottom pixel.
+// Transparent bottom pixels skip the background SGR entirely.
+func renderFrame(img *image.RGBA) []string {
+	bounds := img.Bounds()
+	lines := make([]string, 0, bounds.Dy()/2)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		var line []byte
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			top := img.RGBAAt(x, y)
+			line = append(line, fmt.Sprintf("\x1b[38;2;%d;%d;%dm", top.R, top.G, top.B)...)
+
+			if y+1 < bounds.Max.Y {
+				bottom := img.RGBAAt(x, y+1)
+				if bottom.A > 0 {
+					line = append(line, fmt.Sprintf("\x1b[48;2;%d;%d;%dm", bottom.R, bottom.G, bottom.B)...)
+				}
+			}
+
+			line = append(line, halfBlock...)
+			line = append(line, ResetColor...)
+		}
+		lines = append(lines, string(line))
+	}
+
+	return lines
+}
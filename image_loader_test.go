@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestResizeNearestNeighbor(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, color.RGBA{R: 255, A: 255})
+	src.Set(1, 0, color.RGBA{G: 255, A: 255})
+	src.Set(0, 1, color.RGBA{B: 255, A: 255})
+	src.Set(1, 1, color.RGBA{R: 255, G: 255, A: 255})
+
+	t.Run("same size preserves pixels", func(t *testing.T) {
+		dst := resizeNearestNeighbor(src, 2, 2)
+		if got := dst.RGBAAt(0, 0); got != (color.RGBA{R: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(0, 0) = %v, want red", got)
+		}
+		if got := dst.RGBAAt(1, 1); got != (color.RGBA{R: 255, G: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(1, 1) = %v, want yellow", got)
+		}
+	})
+
+	t.Run("upscale duplicates nearest source pixel", func(t *testing.T) {
+		dst := resizeNearestNeighbor(src, 4, 4)
+		if got, want := dst.Bounds(), image.Rect(0, 0, 4, 4); got != want {
+			t.Fatalf("dst.Bounds() = %v, want %v", got, want)
+		}
+		if got := dst.RGBAAt(0, 0); got != (color.RGBA{R: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(0, 0) = %v, want red", got)
+		}
+		if got := dst.RGBAAt(3, 3); got != (color.RGBA{R: 255, G: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(3, 3) = %v, want yellow", got)
+		}
+	})
+
+	t.Run("odd dimensions don't panic and preserve corners", func(t *testing.T) {
+		dst := resizeNearestNeighbor(src, 3, 5)
+		if got, want := dst.Bounds(), image.Rect(0, 0, 3, 5); got != want {
+			t.Fatalf("dst.Bounds() = %v, want %v", got, want)
+		}
+		if got := dst.RGBAAt(0, 0); got != (color.RGBA{R: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(0, 0) = %v, want red", got)
+		}
+		if got := dst.RGBAAt(2, 4); got != (color.RGBA{R: 255, G: 255, A: 255}) {
+			t.Errorf("dst.RGBAAt(2, 4) = %v, want yellow", got)
+		}
+	})
+}
+
+func TestLoadAnimationDataFromGifDisposal(t *testing.T) {
+	// Index 0 is opaque red, 1 is fully transparent, 2 is opaque green.
+	pal := color.Palette{
+		color.RGBA{R: 255, A: 255},
+		color.Alpha{A: 0},
+		color.RGBA{G: 255, A: 255},
+	}
+
+	t.Run("DisposalNone leaves the previous frame composited underneath", func(t *testing.T) {
+		frame0 := image.NewPaletted(image.Rect(0, 0, 2, 2), pal)
+		for i := range frame0.Pix {
+			frame0.Pix[i] = 0 // entirely red
+		}
+
+		// Frame 1 repaints the top row green and leaves the bottom row
+		// transparent, so a correct DisposalNone composite should still
+		// show frame 0's red underneath on the bottom row.
+		frame1 := image.NewPaletted(image.Rect(0, 0, 2, 2), pal)
+		frame1.Pix = []uint8{2, 2, 1, 1}
+
+		g := &gif.GIF{
+			Image:    []*image.Paletted{frame0, frame1},
+			Delay:    []int{10, 10},
+			Disposal: []byte{gif.DisposalNone, gif.DisposalNone},
+			Config:   image.Config{Width: 2, Height: 2},
+		}
+
+		var buf bytes.Buffer
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			t.Fatalf("EncodeAll: %v", err)
+		}
+
+		file := writeTempGif(t, buf.Bytes())
+		frames, delays, err := loadAnimationDataFromGif(file)
+		if err != nil {
+			t.Fatalf("loadAnimationDataFromGif: %v", err)
+		}
+		if len(frames) != 2 {
+			t.Fatalf("got %d frames, want 2", len(frames))
+		}
+		if len(delays) != 2 {
+			t.Fatalf("got %d delays, want 2", len(delays))
+		}
+		if delays[0] != 100 {
+			t.Errorf("delays[0] = %d, want 100 (10 centiseconds -> ms)", delays[0])
+		}
+
+		// The nearest-neighbor resize maps the source's top row to this
+		// frame's first rendered line and the bottom row to its last,
+		// since the scaled height (ImageHeight*2) is an exact multiple of
+		// the 2-row source. Frame 1's first line should show the green
+		// that frame 1 actually painted; its last line should still show
+		// frame 0's red, composited through frame 1's transparent pixels.
+		frame1Lines := frames[1]
+		if len(frame1Lines) == 0 {
+			t.Fatalf("frames[1] has no lines")
+		}
+		const green = "\x1b[38;2;0;255;0m"
+		const red = "\x1b[38;2;255;0;0m"
+		if top := frame1Lines[0]; !strings.Contains(top, green) {
+			t.Errorf("frames[1] top line = %q, want it to contain frame 1's green %q", top, green)
+		}
+		if bottom := frame1Lines[len(frame1Lines)-1]; !strings.Contains(bottom, red) {
+			t.Errorf("frames[1] bottom line = %q, want it to still contain frame 0's red %q (composited underneath the transparent pixels)", bottom, red)
+		}
+	})
+}
+
+// writeTempGif writes data to a temp file and reopens it for reading, since
+// loadAnimationDataFromGif takes the same *os.File type
+// loadAnimationDataFromImage opens from disk.
+func writeTempGif(t *testing.T, data []byte) *os.File {
+	t.Helper()
+	f, err := os.CreateTemp(t.TempDir(), "gostty-test-*.gif")
+	if err != nil {
+		t.Fatalf("CreateTemp: %v", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatalf("Seek: %v", err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
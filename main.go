@@ -4,13 +4,16 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/term"
 )
@@ -21,13 +24,18 @@ var animationJSON []byte
 // Frame represents a single animation frame
 type Frame struct {
 	Lines []string
+	// DelayMs overrides FrameDelay for this frame when non-zero. This is
+	// populated for sources with variable per-frame timing, such as GIFs.
+	DelayMs int
 }
 
 // Animation handles the animation logic
 type Animation struct {
-	frames         []Frame
-	highlightColor string
-	frameCount     int
+	frames            []Frame
+	highlightColor    string
+	frameCount        int
+	hasVariableTiming bool
+	frameDelayMs      int
 }
 
 // Constants
@@ -67,6 +75,7 @@ var colorMap = map[string]string{
 func NewAnimation() *Animation {
 	return &Animation{
 		highlightColor: "\x1b[34m", // Default blue
+		frameDelayMs:   FrameDelay,
 	}
 }
 
@@ -75,10 +84,19 @@ func (a *Animation) SetHighlightColor(color string) {
 	a.highlightColor = color
 }
 
-// Initialize processes animation data and pre-calculates frames
-func (a *Animation) Initialize(animationData [][]string) {
+// SetFrameDelay overrides the default per-frame delay (e.g. from --fps),
+// used for sources without their own variable per-frame timing.
+func (a *Animation) SetFrameDelay(ms int) {
+	a.frameDelayMs = ms
+}
+
+// Initialize processes animation data and pre-calculates frames. delaysMs
+// may be nil (fixed FrameDelay pacing) or contain a per-frame delay in
+// milliseconds for sources with variable timing, such as GIFs.
+func (a *Animation) Initialize(animationData [][]string, delaysMs []int) {
 	a.frames = make([]Frame, len(animationData))
 	a.frameCount = len(animationData)
+	a.hasVariableTiming = len(delaysMs) == len(animationData) && len(delaysMs) > 0
 
 	for frameIndex, frameLines := range animationData {
 		processedLines := make([]string, len(frameLines))
@@ -87,8 +105,34 @@ func (a *Animation) Initialize(animationData [][]string) {
 			processedLines[lineIndex] = a.processColorCodes(line)
 		}
 
-		a.frames[frameIndex] = Frame{Lines: processedLines}
+		frame := Frame{Lines: processedLines}
+		if a.hasVariableTiming {
+			frame.DelayMs = delaysMs[frameIndex]
+		}
+		a.frames[frameIndex] = frame
+	}
+}
+
+// FrameDelay returns how long the given frame should be displayed for,
+// falling back to frameDelayMs (FrameDelay, unless overridden by --fps)
+// when the source has no variable per-frame timing.
+func (a *Animation) FrameDelay(index int) time.Duration {
+	if a.hasVariableTiming && index >= 0 && index < len(a.frames) && a.frames[index].DelayMs > 0 {
+		return time.Duration(a.frames[index].DelayMs) * time.Millisecond
 	}
+	return time.Duration(a.frameDelayMs) * time.Millisecond
+}
+
+// FrameDelayMs returns the default per-frame delay in milliseconds used
+// for sources without variable per-frame timing.
+func (a *Animation) FrameDelayMs() int {
+	return a.frameDelayMs
+}
+
+// HasVariableTiming reports whether frames should be paced using their
+// individual DelayMs instead of the fixed per-frame delay.
+func (a *Animation) HasVariableTiming() bool {
+	return a.hasVariableTiming
 }
 
 // loadAnimationData loads animation data from a JSON file
@@ -167,8 +211,11 @@ type Terminal struct {
 	lastVerticalPadding   int
 	lastHorizontalPadding int
 	paddingCache          map[int]string
-	newlineCache          map[int]string
 	outputBuffer          []byte
+	output                io.Writer
+	bgColor               string
+	resizePending         atomic.Bool
+	prevGrid              []string
 }
 
 // NewTerminal creates a new terminal instance
@@ -187,11 +234,17 @@ func NewTerminal() *Terminal {
 		shouldRender:   true,
 		lastFrameIndex: -1,
 		paddingCache:   make(map[int]string),
-		newlineCache:   make(map[int]string),
 		outputBuffer:   make([]byte, 0, 64*1024), // 64KB buffer
+		output:         newOutputWriter(),
 	}
 }
 
+// SetBackgroundColor sets the background color applied to the letterbox
+// area surrounding the animation
+func (t *Terminal) SetBackgroundColor(color string) {
+	t.bgColor = color
+}
+
 // GetPaddingString returns cached padding string
 func (t *Terminal) GetPaddingString(width int) string {
 	if str, exists := t.paddingCache[width]; exists {
@@ -202,16 +255,6 @@ func (t *Terminal) GetPaddingString(width int) string {
 	return str
 }
 
-// GetNewlineString returns cached newline string
-func (t *Terminal) GetNewlineString(count int) string {
-	if str, exists := t.newlineCache[count]; exists {
-		return str
-	}
-	str := strings.Repeat("\n", count)
-	t.newlineCache[count] = str
-	return str
-}
-
 // WriteToBuffer writes string to output buffer
 func (t *Terminal) WriteToBuffer(str string) {
 	t.outputBuffer = append(t.outputBuffer, []byte(str)...)
@@ -220,26 +263,39 @@ func (t *Terminal) WriteToBuffer(str string) {
 // FlushBuffer flushes the output buffer to stdout
 func (t *Terminal) FlushBuffer() {
 	if len(t.outputBuffer) > 0 {
-		os.Stdout.Write(t.outputBuffer)
+		t.output.Write(t.outputBuffer)
 		t.outputBuffer = t.outputBuffer[:0] // Reset buffer
 	}
 }
 
-// UpdateSize updates terminal dimensions
-func (t *Terminal) UpdateSize() {
+// UpdateSize updates terminal dimensions, reporting whether they changed
+func (t *Terminal) UpdateSize() bool {
 	width, height, _ := term.GetSize(int(os.Stdout.Fd()))
 	if width != t.width || height != t.height {
 		t.width = width
 		t.height = height
 		t.shouldRender = true
-		// Clear caches on resize
+		// Clear cache on resize
 		t.paddingCache = make(map[int]string)
-		t.newlineCache = make(map[int]string)
+		return true
 	}
+	return false
+}
+
+// MarkResized flags that the terminal size may have changed, typically
+// from a SIGWINCH handler. RenderFrame picks this up on its next call
+// instead of polling the size on every frame.
+func (t *Terminal) MarkResized() {
+	t.resizePending.Store(true)
 }
 
 // RenderFrame renders a single frame
 func (t *Terminal) RenderFrame(animation *Animation, frameIndex int) {
+	resized := false
+	if t.resizePending.CompareAndSwap(true, false) {
+		resized = t.UpdateSize()
+	}
+
 	verticalPadding := max(0, (t.height-ImageHeight)/2)
 	horizontalPadding := max(0, (t.width-ImageWidth)/2)
 
@@ -258,41 +314,269 @@ func (t *Terminal) RenderFrame(animation *Animation, frameIndex int) {
 		return
 	}
 
-	// Get cached padding strings
+	// Get cached padding string
 	paddingStr := t.GetPaddingString(horizontalPadding)
-	verticalPaddingStr := t.GetNewlineString(verticalPadding)
+
+	// Build this frame's rows: blank rows for the vertical letterbox,
+	// followed by each padded, colored animation line
+	lines := animation.GetFrameLines(frameIndex)
+	rows := make([]string, 0, verticalPadding+len(lines))
+	blankRow := ""
+	if t.bgColor != "" {
+		blankRow = t.bgColor + strings.Repeat(" ", t.width) + ResetColor
+	}
+	for i := 0; i < verticalPadding; i++ {
+		rows = append(rows, blankRow)
+	}
+	for _, line := range lines {
+		var row strings.Builder
+		if t.bgColor != "" {
+			row.WriteString(t.bgColor)
+		}
+		row.WriteString(paddingStr)
+		row.WriteString(line)
+		if t.bgColor != "" {
+			row.WriteString(ResetColor)
+		}
+		rows = append(rows, row.String())
+	}
 
 	// Start fresh buffer
 	t.outputBuffer = t.outputBuffer[:0]
 
-	// Clear screen and move cursor to home
-	t.WriteToBuffer(ClearAndHome)
-
-	// Add vertical padding
-	if verticalPadding > 0 {
-		t.WriteToBuffer(verticalPaddingStr)
-	}
+	// A full repaint (clear + write every row) is only needed on the first
+	// frame or right after a resize; otherwise we diff against the
+	// previously rendered rows and only rewrite the ones that changed,
+	// addressing each with a cursor move instead of clearing the screen.
+	// A resize can change the terminal dimensions without changing the
+	// derived padding (e.g. two heights that divide to the same value), so
+	// this checks the raw resize signal rather than relying on paddingChanged.
+	if t.prevGrid == nil || resized {
+		t.WriteToBuffer(ClearAndHome)
+		for i, row := range rows {
+			t.WriteToBuffer(row)
+			if i < len(rows)-1 {
+				t.WriteToBuffer("\n")
+			}
+		}
+	} else {
+		for i, row := range rows {
+			if i < len(t.prevGrid) && row == t.prevGrid[i] {
+				continue
+			}
+			var prevRow string
+			if i < len(t.prevGrid) {
+				prevRow = t.prevGrid[i]
+			}
+			t.writeRowDiff(i, prevRow, row)
+		}
 
-	// Get pre-split lines and render
-	lines := animation.GetFrameLines(frameIndex)
-	for i, line := range lines {
-		t.WriteToBuffer(paddingStr)
-		t.WriteToBuffer(line)
-		if i < len(lines)-1 {
-			t.WriteToBuffer("\n")
+		// The new frame has fewer rows than the last one (a shorter source,
+		// or the terminal shrank); blank what's left of the taller rows so
+		// they don't linger on screen.
+		for i := len(rows); i < len(t.prevGrid); i++ {
+			t.WriteToBuffer(fmt.Sprintf("\x1b[%d;1H\x1b[K", i+1))
 		}
 	}
 
 	// Flush the buffer to stdout
 	t.FlushBuffer()
+	t.prevGrid = rows
 	t.shouldRender = false
 	t.lastFrameIndex = frameIndex
 }
 
+// ansiEscapeRegex matches the SGR and other escape sequences that can
+// appear inside a rendered row (colorMap/highlight codes, truecolor and
+// 256-palette SGR from image-sourced frames, ResetColor).
+var ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+// cell is one visible character of a rendered row together with any escape
+// sequences that immediately precede it (esc) and the SGR state actually in
+// effect at that position (active): the most recent non-empty esc at or
+// before this cell, carried forward across cells that have none of their
+// own. A trailing cell with ch == 0 represents escape sequences with no
+// following character, such as a ResetColor at the very end of a row.
+type cell struct {
+	esc    string
+	active string
+	ch     rune
+}
+
+// tokenizeRow splits a rendered row into cells so it can be diffed against
+// the previous frame's row at character granularity instead of as a whole
+// string.
+func tokenizeRow(row string) []cell {
+	var cells []cell
+	var pending strings.Builder
+	var active string
+
+	matches := ansiEscapeRegex.FindAllStringIndex(row, -1)
+	matchIndex := 0
+
+	for i := 0; i < len(row); {
+		if matchIndex < len(matches) && matches[matchIndex][0] == i {
+			pending.WriteString(row[matches[matchIndex][0]:matches[matchIndex][1]])
+			i = matches[matchIndex][1]
+			matchIndex++
+			continue
+		}
+
+		ch, size := utf8.DecodeRuneInString(row[i:])
+		if pending.Len() > 0 {
+			active = pending.String()
+		}
+		cells = append(cells, cell{esc: pending.String(), active: active, ch: ch})
+		pending.Reset()
+		i += size
+	}
+
+	if pending.Len() > 0 {
+		active = pending.String()
+		cells = append(cells, cell{esc: pending.String(), active: active})
+	}
+
+	return cells
+}
+
+// writeRowDiff compares prevRow and newRow cell by cell and writes only the
+// runs of consecutively changed cells, each addressed with a single cursor
+// move. A cell is considered changed if its character or its *effective*
+// SGR state (cell.active, not just its own literal esc) differs from the
+// previous frame — a cell in the middle of a colored span can carry no
+// escape of its own yet still need rewriting because an earlier cell in
+// that span changed color. Unchanged cells are left untouched on screen,
+// and runs of changed cells that are adjacent in the same row are
+// coalesced into one move-and-write instead of one per cell.
+//
+// The first cell written in each run always emits its active state rather
+// than its literal esc, since a cursor-addressed write lands wherever the
+// terminal's SGR state was last left by some unrelated previous write, not
+// necessarily the state this span needs; every other cell in the run emits
+// only its own literal esc, which is empty unless that cell changes the
+// color again.
+func (t *Terminal) writeRowDiff(rowIndex int, prevRow, newRow string) {
+	prevCells := tokenizeRow(prevRow)
+	newCells := tokenizeRow(newRow)
+
+	runStart := -1
+	flushRun := func(end int) {
+		if runStart == -1 {
+			return
+		}
+		t.WriteToBuffer(fmt.Sprintf("\x1b[%d;%dH", rowIndex+1, runStart+1))
+		for i, c := range newCells[runStart:end] {
+			if i == 0 {
+				if c.active == "" {
+					// No SGR precedes this cell in its row, so it needs the
+					// default state rather than whatever was left active.
+					t.WriteToBuffer(ResetColor)
+				} else {
+					t.WriteToBuffer(c.active)
+				}
+			} else {
+				t.WriteToBuffer(c.esc)
+			}
+			if c.ch != 0 {
+				t.WriteToBuffer(string(c.ch))
+			}
+		}
+		runStart = -1
+	}
+
+	count := max(len(prevCells), len(newCells))
+	for i := 0; i < count; i++ {
+		var prevCell, newCell cell
+		if i < len(prevCells) {
+			prevCell = prevCells[i]
+		}
+		if i < len(newCells) {
+			newCell = newCells[i]
+		}
+
+		changed := newCell.ch != prevCell.ch || newCell.active != prevCell.active
+		if i < len(newCells) && changed {
+			if runStart == -1 {
+				runStart = i
+			}
+			continue
+		}
+
+		flushRun(i)
+	}
+	flushRun(len(newCells))
+
+	// The previous row had trailing cells (e.g. it was visually wider)
+	// that no longer exist; erase them.
+	if len(prevCells) > len(newCells) {
+		t.WriteToBuffer(fmt.Sprintf("\x1b[%d;%dH\x1b[K", rowIndex+1, len(newCells)+1))
+	}
+}
+
 // Config holds application configuration
 type Config struct {
 	colorArg          string
+	bgArg             string
 	durationInSeconds int
+	imagePath         string
+	imageIsGif        bool
+	fps               int
+}
+
+var (
+	hexColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{6})$`)
+	rgbColorRegex = regexp.MustCompile(`^rgb\(\s*(\d+)\s*,\s*(\d+)\s*,\s*(\d+)\s*\)$`)
+	paletteRegex  = regexp.MustCompile(`^256:(\d+)$`)
+	digitRegex    = regexp.MustCompile(`^\d+$`)
+)
+
+// parseColorArg parses a `-c`/`--bg` argument into its ANSI SGR escape
+// sequence. It accepts raw escape sequences (passed through unchanged),
+// bare SGR numbers (wrapped as `\x1b[<n>m`), named colors from colorMap, 24-bit truecolor
+// as `#RRGGBB` or `rgb(r,g,b)`, and 256-color palette indices as
+// `256:<n>`. sgrBase selects 38 (foreground) or 48 (background) for the
+// truecolor and palette forms, and named colors are shifted from
+// foreground to background codes when sgrBase is 48.
+func parseColorArg(color string, sgrBase int) string {
+	if strings.HasPrefix(color, "\x1b[") {
+		return color
+	} else if digitRegex.MatchString(color) {
+		return fmt.Sprintf("\x1b[%sm", color)
+	} else if m := hexColorRegex.FindStringSubmatch(color); m != nil {
+		r, g, b := hexByte(m[1][0:2]), hexByte(m[1][2:4]), hexByte(m[1][4:6])
+		return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", sgrBase, r, g, b)
+	} else if m := rgbColorRegex.FindStringSubmatch(color); m != nil {
+		r, _ := strconv.Atoi(m[1])
+		g, _ := strconv.Atoi(m[2])
+		b, _ := strconv.Atoi(m[3])
+		return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", sgrBase, r, g, b)
+	} else if m := paletteRegex.FindStringSubmatch(color); m != nil {
+		return fmt.Sprintf("\x1b[%d;5;%sm", sgrBase, m[1])
+	} else if ansiColor, exists := colorMap[strings.ToLower(color)]; exists {
+		if sgrBase == 48 {
+			return foregroundToBackground(ansiColor)
+		}
+		return ansiColor
+	}
+
+	return "\x1b[34m" // Default to blue
+}
+
+// hexByte parses a two-character hex string into its byte value.
+func hexByte(s string) int {
+	n, _ := strconv.ParseInt(s, 16, 0)
+	return int(n)
+}
+
+// foregroundToBackground converts one of colorMap's foreground SGR codes
+// (30-37, 90-97) into its background equivalent (40-47, 100-107).
+func foregroundToBackground(ansiColor string) string {
+	code := strings.TrimSuffix(strings.TrimPrefix(ansiColor, "\x1b["), "m")
+	n, err := strconv.Atoi(code)
+	if err != nil {
+		return ansiColor
+	}
+	return fmt.Sprintf("\x1b[%dm", n+10)
 }
 
 // ParseArgs parses command line arguments
@@ -310,17 +594,24 @@ func ParseArgs() (*Config, error) {
 			os.Exit(0)
 		case "--color", "-c":
 			if i+1 < len(args) {
-				color := args[i+1]
-				var digitRegex = regexp.MustCompile(`^\d+$`)
-				if strings.HasPrefix(color, "\x1b[") {
-					config.colorArg = color
-				} else if digitRegex.MatchString(color) {
-					config.colorArg = fmt.Sprintf("\x1b[%sm", color)
-				} else if ansiColor, exists := colorMap[strings.ToLower(color)]; exists {
-					config.colorArg = ansiColor
-				} else {
-					config.colorArg = "\x1b[34m" // Default to blue
-				}
+				config.colorArg = parseColorArg(args[i+1], 38)
+				i++ // Skip next argument
+			}
+		case "--bg":
+			if i+1 < len(args) {
+				config.bgArg = parseColorArg(args[i+1], 48)
+				i++ // Skip next argument
+			}
+		case "--image":
+			if i+1 < len(args) {
+				config.imagePath = args[i+1]
+				config.imageIsGif = false
+				i++ // Skip next argument
+			}
+		case "--gif":
+			if i+1 < len(args) {
+				config.imagePath = args[i+1]
+				config.imageIsGif = true
 				i++ // Skip next argument
 			}
 		case "--timer", "-t":
@@ -330,6 +621,13 @@ func ParseArgs() (*Config, error) {
 				}
 				i++ // Skip next argument
 			}
+		case "--fps":
+			if i+1 < len(args) {
+				if fps, err := strconv.Atoi(args[i+1]); err == nil && fps > 0 {
+					config.fps = fps
+				}
+				i++ // Skip next argument
+			}
 		}
 	}
 
@@ -342,25 +640,64 @@ func showColorHelp() {
 	for name, code := range colorMap {
 		fmt.Printf("  %s%s%s\n", code, name, ResetColor)
 	}
+
+	fmt.Println("\n256-color palette sample (6x6x6 cube):")
+	for r := 0; r < 6; r++ {
+		var row strings.Builder
+		for g := 0; g < 6; g++ {
+			for b := 0; b < 6; b++ {
+				index := 16 + 36*r + 6*g + b
+				row.WriteString(fmt.Sprintf("\x1b[48;5;%dm  %s", index, ResetColor))
+			}
+		}
+		fmt.Println("  " + row.String())
+	}
+
 	fmt.Println("\nUsage:")
 	fmt.Println("  gostty -c <color>        Use a color name from the list above")
 	fmt.Println("  gostty -c <number>       Use an ANSI color code (30-37 or 90-97)")
+	fmt.Println("  gostty -c '#RRGGBB'      Use a 24-bit truecolor hex value")
+	fmt.Println("  gostty -c 'rgb(r,g,b)'   Use a 24-bit truecolor RGB triple")
+	fmt.Println("  gostty -c '256:<n>'      Use a 256-color palette index")
+	fmt.Println("  gostty --bg <color>      Set the background color of the letterbox area (same formats as -c)")
 	fmt.Println("  gostty --colors          Show this color help")
 	fmt.Println("  gostty -t <seconds>      Run animation for specified duration")
+	fmt.Println("  gostty --image <path>    Play a PNG/JPEG image instead of the built-in animation")
+	fmt.Println("  gostty --gif <path>      Play an animated GIF instead of the built-in animation")
+	fmt.Println("  gostty --fps <n>         Override the animation's default frame rate")
 }
 
 // cleanup performs cleanup operations
-func cleanup() {
+func cleanup(w io.Writer) {
 	// Disable focus reporting, show cursor and restore main screen buffer
-	fmt.Print("\x1b[?25h\x1b[?1049l")
+	io.WriteString(w, "\x1b[?25h\x1b[?1049l")
 }
 
-// runAnimation runs the main animation loop
+// runAnimation runs the main animation loop. On platforms with a resize
+// signal (see resizeSignals), terminal resizes arrive via SIGWINCH (see
+// terminal.MarkResized) instead of being polled here; on platforms without
+// one, pollForResize flags the terminal as possibly resized every frame.
 func runAnimation(animation *Animation, terminal *Terminal, config *Config) {
 	start := time.Now()
 
-	ticker := time.NewTicker(time.Millisecond * FrameDelay)
-	defer ticker.Stop()
+	// Sources with variable per-frame timing (e.g. GIFs) are paced frame by
+	// frame rather than against a fixed cadence.
+	if animation.HasVariableTiming() {
+		frameIndex := 0
+		for {
+			if config.durationInSeconds > 0 && time.Since(start) >= time.Duration(config.durationInSeconds)*time.Second {
+				return
+			}
+
+			pollForResize(terminal)
+			terminal.RenderFrame(animation, frameIndex)
+
+			time.Sleep(animation.FrameDelay(frameIndex))
+			frameIndex = (frameIndex + 1) % animation.FrameCount()
+		}
+	}
+
+	frameDelay := animation.FrameDelay(0)
 
 	for {
 		now := time.Now()
@@ -371,16 +708,22 @@ func runAnimation(animation *Animation, terminal *Terminal, config *Config) {
 			return
 		}
 
-		// Update terminal size
-		terminal.UpdateSize()
-
-		// Calculate frame index based on actual animation time
+		// Calculate frame index based on actual animation time, so a slow
+		// render doesn't cause the animation to fall behind wall-clock time
 		effectiveElapsed := now.Sub(start)
-		frameIndex := int(effectiveElapsed.Milliseconds()/FrameDelay) % animation.FrameCount()
+		frameIndex := int(effectiveElapsed.Milliseconds()/int64(animation.FrameDelayMs())) % animation.FrameCount()
 
+		pollForResize(terminal)
+		renderStart := time.Now()
 		terminal.RenderFrame(animation, frameIndex)
+		renderDuration := time.Since(renderStart)
 
-		<-ticker.C
+		// Only sleep off the remaining frame budget; a render that overran
+		// the budget skips straight to the next iteration, which naturally
+		// jumps ahead to whatever frame wall-clock time now calls for
+		if renderDuration < frameDelay {
+			time.Sleep(frameDelay - renderDuration)
+		}
 	}
 }
 
@@ -392,37 +735,63 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Load animation data from embedded JSON
-	animationData, err := loadAnimationDataFromEmbedded()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to load animation data: %v\n", err)
-		os.Exit(1)
+	// Load animation data, either from a user-supplied image/GIF or the
+	// embedded default animation
+	var animationData [][]string
+	var delaysMs []int
+	if config.imagePath != "" {
+		animationData, delaysMs, err = loadAnimationDataFromImage(config.imagePath, config.imageIsGif)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load %s: %v\n", config.imagePath, err)
+			os.Exit(1)
+		}
+	} else {
+		animationData, err = loadAnimationDataFromEmbedded()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load animation data: %v\n", err)
+			os.Exit(1)
+		}
 	}
 
 	// Initialize animation and terminal
 	animation := NewAnimation()
 	terminal := NewTerminal()
 
-	// Set highlight color based on user input
+	// Set highlight and background colors based on user input
 	animation.SetHighlightColor(config.colorArg)
+	terminal.SetBackgroundColor(config.bgArg)
+	if config.fps > 0 {
+		animation.SetFrameDelay(1000 / config.fps)
+	}
 
 	// Initialize animation with loaded data
-	animation.Initialize(animationData)
+	animation.Initialize(animationData, delaysMs)
 
-	// Setup signal handling for cleanup
+	// Setup signal handling: SIGINT/SIGTERM trigger cleanup and exit; on
+	// platforms that have one (see resizeSignals), a resize signal just
+	// flags the terminal as resized for the next render
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(c, append([]os.Signal{os.Interrupt, syscall.SIGTERM}, resizeSignals()...)...)
+	resizeSignalSet := make(map[os.Signal]bool)
+	for _, sig := range resizeSignals() {
+		resizeSignalSet[sig] = true
+	}
 	go func() {
-		<-c
-		cleanup()
-		os.Exit(0)
+		for sig := range c {
+			if resizeSignalSet[sig] {
+				terminal.MarkResized()
+				continue
+			}
+			cleanup(terminal.output)
+			os.Exit(0)
+		}
 	}()
 
 	// Enable screen buffer
-	fmt.Print("\x1b[?1049h\x1b[?25l")
+	io.WriteString(terminal.output, "\x1b[?1049h\x1b[?25l")
 
 	// Cleanup on exit
-	defer cleanup()
+	defer cleanup(terminal.output)
 
 	// Start the animation
 	runAnimation(animation, terminal, config)
@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestParseColorArg(t *testing.T) {
+	tests := []struct {
+		name    string
+		color   string
+		sgrBase int
+		want    string
+	}{
+		{"passthrough escape", "\x1b[91m", 38, "\x1b[91m"},
+		{"bare digit", "35", 38, "\x1b[35m"},
+		{"hex foreground", "#ff0000", 38, "\x1b[38;2;255;0;0m"},
+		{"hex background", "#00ff00", 48, "\x1b[48;2;0;255;0m"},
+		{"rgb foreground", "rgb(10,20,30)", 38, "\x1b[38;2;10;20;30m"},
+		{"256 palette", "256:196", 38, "\x1b[38;5;196m"},
+		{"named foreground", "blue", 38, "\x1b[34m"},
+		{"named background shifts code", "blue", 48, "\x1b[44m"},
+		{"named case-insensitive", "BrightCyan", 38, "\x1b[96m"},
+		{"malformed hex falls back to default", "#zzzzzz", 38, "\x1b[34m"},
+		{"unknown name falls back to default", "nope", 38, "\x1b[34m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseColorArg(tt.color, tt.sgrBase); got != tt.want {
+				t.Errorf("parseColorArg(%q, %d) = %q, want %q", tt.color, tt.sgrBase, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForegroundToBackground(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"basic color", "\x1b[31m", "\x1b[41m"},
+		{"bright color", "\x1b[91m", "\x1b[101m"},
+		{"not a bare code passes through unchanged", "\x1b[38;2;1;2;3m", "\x1b[38;2;1;2;3m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := foregroundToBackground(tt.input); got != tt.want {
+				t.Errorf("foregroundToBackground(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTokenizeRowTracksActiveState(t *testing.T) {
+	row := "AB\x1b[31mCDE" + ResetColor
+	cells := tokenizeRow(row)
+
+	want := []struct {
+		ch     rune
+		active string
+	}{
+		{'A', ""},
+		{'B', ""},
+		{'C', "\x1b[31m"},
+		{'D', "\x1b[31m"},
+		{'E', "\x1b[31m"},
+	}
+
+	if len(cells) < len(want) {
+		t.Fatalf("tokenizeRow(%q) returned %d cells, want at least %d", row, len(cells), len(want))
+	}
+	for i, w := range want {
+		if cells[i].ch != w.ch || cells[i].active != w.active {
+			t.Errorf("cells[%d] = %+v, want ch=%q active=%q", i, cells[i], w.ch, w.active)
+		}
+	}
+
+	// The trailing ResetColor carries no character of its own, so it should
+	// surface as a final phantom cell whose active state is the reset.
+	last := cells[len(cells)-1]
+	if last.ch != 0 || last.active != ResetColor {
+		t.Errorf("trailing cell = %+v, want ch=0 active=%q", last, ResetColor)
+	}
+}
+
+func TestWriteRowDiffResumesColorAcrossUnchangedCells(t *testing.T) {
+	var buf bytes.Buffer
+	term := &Terminal{output: &buf}
+
+	prevRow := "AB\x1b[31mCDE"
+	newRow := "AB\x1b[34mCDE"
+
+	term.writeRowDiff(0, prevRow, newRow)
+
+	want := "\x1b[1;3H\x1b[34mCDE"
+	if got := string(term.outputBuffer); got != want {
+		t.Errorf("writeRowDiff rewrote %q, want %q (D and E must follow C's new color)", got, want)
+	}
+}
+
+func TestWriteRowDiffSkipsTrulyUnchangedCells(t *testing.T) {
+	var buf bytes.Buffer
+	term := &Terminal{output: &buf}
+
+	row := "AB\x1b[31mCDE"
+	term.writeRowDiff(0, row, row)
+
+	if got := string(term.outputBuffer); got != "" {
+		t.Errorf("writeRowDiff on an unchanged row wrote %q, want no output", got)
+	}
+}
+
+func TestWriteRowDiffErasesShrunkTrailingCells(t *testing.T) {
+	var buf bytes.Buffer
+	term := &Terminal{output: &buf}
+
+	term.writeRowDiff(0, "ABCDE", "ABC")
+
+	want := "\x1b[1;4H\x1b[K"
+	if got := string(term.outputBuffer); got != want {
+		t.Errorf("writeRowDiff = %q, want %q", got, want)
+	}
+}
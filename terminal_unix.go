@@ -0,0 +1,26 @@
+//go:build !windows
+
+package main
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// newOutputWriter returns the writer used for terminal output. On
+// non-Windows platforms, ANSI escape sequences are natively supported, so
+// this is just stdout.
+func newOutputWriter() io.Writer {
+	return os.Stdout
+}
+
+// resizeSignals returns the OS signal(s) that indicate the terminal size
+// may have changed.
+func resizeSignals() []os.Signal {
+	return []os.Signal{syscall.SIGWINCH}
+}
+
+// pollForResize is a no-op here: SIGWINCH (see resizeSignals) already keeps
+// the terminal's resizePending flag up to date without polling.
+func pollForResize(t *Terminal) {}
@@ -0,0 +1,359 @@
+//go:build windows
+
+package main
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const enableVirtualTerminalProcessing = 0x0004
+
+// Win32 console text attribute bits, matching the foreground/background
+// colors in colorMap.
+const (
+	fgBlue      = 0x0001
+	fgGreen     = 0x0002
+	fgRed       = 0x0004
+	fgIntensity = 0x0008
+	bgBlue      = 0x0010
+	bgGreen     = 0x0020
+	bgRed       = 0x0040
+	bgIntensity = 0x0080
+
+	fgMask = fgBlue | fgGreen | fgRed | fgIntensity
+	bgMask = bgBlue | bgGreen | bgRed | bgIntensity
+
+	defaultAttr = fgRed | fgGreen | fgBlue
+)
+
+// golang.org/x/sys/windows doesn't wrap these console-buffer APIs, so they're
+// called directly through kernel32 the same way the package itself calls
+// other DLL procs it hasn't wrapped.
+var (
+	kernel32                        = syscall.NewLazyDLL("kernel32.dll")
+	procSetConsoleTextAttribute     = kernel32.NewProc("SetConsoleTextAttribute")
+	procFillConsoleOutputCharacterW = kernel32.NewProc("FillConsoleOutputCharacterW")
+	procFillConsoleOutputAttribute  = kernel32.NewProc("FillConsoleOutputAttribute")
+)
+
+// packCoord packs a windows.Coord into the single DWORD the Win32 console
+// APIs below expect it as (low word X, high word Y).
+func packCoord(c windows.Coord) uintptr {
+	return uintptr(uint16(c.X)) | uintptr(uint16(c.Y))<<16
+}
+
+func setConsoleTextAttribute(handle windows.Handle, attr uint16) {
+	procSetConsoleTextAttribute.Call(uintptr(handle), uintptr(attr))
+}
+
+func fillConsoleOutputCharacter(handle windows.Handle, ch rune, length uint32, origin windows.Coord) {
+	var written uint32
+	procFillConsoleOutputCharacterW.Call(uintptr(handle), uintptr(ch), uintptr(length), packCoord(origin), uintptr(unsafe.Pointer(&written)))
+}
+
+func fillConsoleOutputAttribute(handle windows.Handle, attr uint16, length uint32, origin windows.Coord) {
+	var written uint32
+	procFillConsoleOutputAttribute.Call(uintptr(handle), uintptr(attr), uintptr(length), packCoord(origin), uintptr(unsafe.Pointer(&written)))
+}
+
+// writeConsoleString writes s to the console via the real WriteConsole
+// signature (buf *uint16, towrite uint32, written *uint32, reserved *byte).
+func writeConsoleString(handle windows.Handle, s string) {
+	if s == "" {
+		return
+	}
+	buf := utf16Encode(s)
+	var written uint32
+	windows.WriteConsole(handle, &buf[0], uint32(len(buf)), &written, nil)
+}
+
+// newOutputWriter returns the writer used for terminal output. Modern
+// Windows Terminal / PowerShell hosts understand ANSI escapes natively, so
+// we try to turn on virtual terminal processing first and pass sequences
+// through unchanged. Legacy consoles (old conhost, older PowerShell hosts)
+// reject that mode switch, so we fall back to translating the escape
+// sequences gostty emits into Win32 console API calls.
+func newOutputWriter() io.Writer {
+	handle := windows.Handle(os.Stdout.Fd())
+
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err == nil {
+		if windows.SetConsoleMode(handle, mode|enableVirtualTerminalProcessing) == nil {
+			return os.Stdout
+		}
+	}
+
+	return newWin32Writer(handle)
+}
+
+// resizeSignals returns the OS signal(s) that indicate the terminal size
+// may have changed. Windows has no SIGWINCH equivalent delivered through
+// os/signal, so there's nothing to register here; pollForResize is what
+// keeps resize detection working on this platform instead.
+func resizeSignals() []os.Signal {
+	return nil
+}
+
+// pollForResize flags the terminal as possibly resized on every call, since
+// resizeSignals has no signal to listen for on Windows. RenderFrame's
+// UpdateSize still no-ops when the size hasn't actually changed, so this
+// just trades a per-frame syscall for keeping resize support working after
+// launch.
+func pollForResize(t *Terminal) {
+	t.MarkResized()
+}
+
+// ansiSequenceRegex matches the escape sequences gostty emits: SGR
+// (`\x1b[...m`), clear+home (`\x1b[2J` / `\x1b[H`) and the alt-screen /
+// cursor-visibility sequences used around the render loop.
+var ansiSequenceRegex = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+// win32Writer translates the ANSI escape sequences gostty emits into
+// Win32 console API calls for terminals that don't interpret them natively.
+type win32Writer struct {
+	handle windows.Handle
+	attr   uint16
+}
+
+func newWin32Writer(handle windows.Handle) *win32Writer {
+	return &win32Writer{handle: handle, attr: defaultAttr}
+}
+
+func (w *win32Writer) Write(p []byte) (int, error) {
+	text := string(p)
+	lastEnd := 0
+
+	for _, loc := range ansiSequenceRegex.FindAllStringIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if start > lastEnd {
+			w.writePlain(text[lastEnd:start])
+		}
+		w.applySequence(text[start:end])
+		lastEnd = end
+	}
+
+	if lastEnd < len(text) {
+		w.writePlain(text[lastEnd:])
+	}
+
+	return len(p), nil
+}
+
+// writePlain writes text (which may contain newlines) honoring the current
+// SGR attribute and advancing the console cursor.
+func (w *win32Writer) writePlain(s string) {
+	setConsoleTextAttribute(w.handle, w.attr)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		writeConsoleString(w.handle, line)
+		if i < len(lines)-1 {
+			writeConsoleString(w.handle, "\r\n")
+		}
+	}
+}
+
+// applySequence interprets a single ANSI escape sequence.
+func (w *win32Writer) applySequence(seq string) {
+	body := strings.TrimSuffix(strings.TrimPrefix(seq, "\x1b["), seq[len(seq)-1:])
+	final := seq[len(seq)-1]
+
+	switch final {
+	case 'm':
+		w.applySGR(body)
+	case 'J':
+		if body == "2" {
+			w.clearScreen()
+		}
+	case 'H':
+		w.moveCursor(body)
+	}
+}
+
+// applySGR updates the tracked text attribute for `\x1b[<params>m`
+// sequences. It handles the plain foreground/background codes produced by
+// colorMap and ResetColor, the compound `38;2;r;g;b` / `38;5;n` (and their
+// `48;...` background equivalents) emitted by parseColorArg's truecolor and
+// 256-palette forms, and leaves the attribute untouched for any other SGR
+// code (bold, underline, ...) this legacy console path doesn't support.
+func (w *win32Writer) applySGR(params string) {
+	if params == "" {
+		w.attr = defaultAttr
+		return
+	}
+
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		n, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case n == 0:
+			w.attr = defaultAttr
+		case n == 38:
+			bits, consumed := extendedColorBits(codes[i+1:])
+			w.attr = (w.attr &^ fgMask) | bits
+			i += consumed
+		case n == 48:
+			bits, consumed := extendedColorBits(codes[i+1:])
+			w.attr = (w.attr &^ bgMask) | (bits << 4)
+			i += consumed
+		default:
+			if bits, isBackground, ok := ansiCodeToBits(n); ok {
+				if isBackground {
+					w.attr = (w.attr &^ bgMask) | bits
+				} else {
+					w.attr = (w.attr &^ fgMask) | bits
+				}
+			}
+		}
+	}
+}
+
+// extendedColorBits parses the parameters following a 38 (foreground) or 48
+// (background) SGR code — either "2;r;g;b" (truecolor) or "5;n" (256-color
+// palette) — approximated to the nearest of the 16 legacy console colors,
+// since the Win32 console attribute has no truecolor equivalent. It returns
+// foreground-shaped color bits (to be shifted for background use by the
+// caller) and how many of params it consumed.
+func extendedColorBits(params []string) (bits uint16, consumed int) {
+	if len(params) == 0 {
+		return 0, 0
+	}
+
+	switch params[0] {
+	case "2":
+		if len(params) < 4 {
+			return 0, len(params)
+		}
+		r, _ := strconv.Atoi(params[1])
+		g, _ := strconv.Atoi(params[2])
+		b, _ := strconv.Atoi(params[3])
+		return rgbToBits(r, g, b), 4
+	case "5":
+		if len(params) < 2 {
+			return 0, len(params)
+		}
+		index, _ := strconv.Atoi(params[1])
+		return paletteToBits(index), 2
+	}
+
+	return 0, 1
+}
+
+// rgbToBits approximates a 24-bit color as the nearest combination of the
+// legacy console's red/green/blue/intensity bits.
+func rgbToBits(r, g, b int) uint16 {
+	var bits uint16
+	if r > 127 {
+		bits |= fgRed
+	}
+	if g > 127 {
+		bits |= fgGreen
+	}
+	if b > 127 {
+		bits |= fgBlue
+	}
+	if (r+g+b)/3 > 170 {
+		bits |= fgIntensity
+	}
+	return bits
+}
+
+// paletteToBits maps a 256-color palette index to the nearest legacy
+// console color: indices 0-15 are the standard 16 colors, 16-231 are the
+// 6x6x6 truecolor cube, and 232-255 are the grayscale ramp.
+func paletteToBits(index int) uint16 {
+	switch {
+	case index < 8:
+		return foregroundBits(index)
+	case index < 16:
+		return foregroundBits(index-8) | fgIntensity
+	case index <= 231:
+		cubeIndex := index - 16
+		r := (cubeIndex / 36) * 51
+		g := ((cubeIndex / 6) % 6) * 51
+		b := (cubeIndex % 6) * 51
+		return rgbToBits(r, g, b)
+	default:
+		level := (index - 232) * 10
+		return rgbToBits(level, level, level)
+	}
+}
+
+// ansiCodeToBits maps a bare SGR foreground/background code (30-37, 40-47,
+// 90-97, 100-107 — the forms parseColorArg's digit passthrough and
+// foregroundToBackground can emit) to console attribute bits.
+func ansiCodeToBits(code int) (bits uint16, isBackground bool, ok bool) {
+	switch {
+	case code >= 30 && code <= 37:
+		return foregroundBits(code - 30), false, true
+	case code >= 90 && code <= 97:
+		return foregroundBits(code-90) | fgIntensity, false, true
+	case code >= 40 && code <= 47:
+		return foregroundBits(code-40) << 4, true, true
+	case code >= 100 && code <= 107:
+		return (foregroundBits(code-100) | fgIntensity) << 4, true, true
+	}
+	return 0, false, false
+}
+
+// foregroundBits maps a base ANSI color number (0-7: black, red, green,
+// yellow, blue, magenta, cyan, white) to the matching low-nibble console
+// attribute bits.
+func foregroundBits(base int) uint16 {
+	var bits uint16
+	if base&1 != 0 {
+		bits |= fgRed
+	}
+	if base&2 != 0 {
+		bits |= fgGreen
+	}
+	if base&4 != 0 {
+		bits |= fgBlue
+	}
+	return bits
+}
+
+// clearScreen handles `\x1b[2J` by filling the console buffer with blanks.
+func (w *win32Writer) clearScreen() {
+	var info windows.ConsoleScreenBufferInfo
+	if windows.GetConsoleScreenBufferInfo(w.handle, &info) != nil {
+		return
+	}
+	size := uint32(info.Size.X) * uint32(info.Size.Y)
+	origin := windows.Coord{}
+	fillConsoleOutputCharacter(w.handle, ' ', size, origin)
+	fillConsoleOutputAttribute(w.handle, w.attr, size, origin)
+}
+
+// moveCursor handles `\x1b[H` (home) and `\x1b[<row>;<col>H`.
+func (w *win32Writer) moveCursor(params string) {
+	row, col := 1, 1
+	if params != "" {
+		parts := strings.SplitN(params, ";", 2)
+		if n, err := strconv.Atoi(parts[0]); err == nil {
+			row = n
+		}
+		if len(parts) > 1 {
+			if n, err := strconv.Atoi(parts[1]); err == nil {
+				col = n
+			}
+		}
+	}
+	windows.SetConsoleCursorPosition(w.handle, windows.Coord{X: int16(col - 1), Y: int16(row - 1)})
+}
+
+// utf16Encode converts a string to UTF-16 for WriteConsole.
+func utf16Encode(s string) []uint16 {
+	return windows.StringToUTF16(s)
+}